@@ -0,0 +1,35 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package bootstrap
+
+import "context"
+
+// Discoverer lets Joiner populate remoteNodes from a backend other than the static
+// ClusterStateReader.AllClusterMembers map, so peers that aren't known ahead of time (e.g. on
+// a scheduler that assigns addresses at runtime) can still be found.
+type Discoverer interface {
+	// Register advertises the local node (nodeID, raftAddr) to the backend so that other
+	// nodes' Discover calls can find it. Implementations that support it should back the
+	// registration with a session/lease so it expires automatically if the node dies without
+	// deregistering.
+	Register(ctx context.Context, nodeID, raftAddr string) error
+	// Deregister removes the local node's registration. Safe to call even if the backend
+	// already expired it.
+	Deregister(ctx context.Context, nodeID string) error
+	// Discover returns every node currently registered, keyed by node ID, mapped to its raft
+	// address. Called on every retry of Joiner.Do so that peers registered after the first
+	// attempt are picked up.
+	Discover(ctx context.Context) (map[string]string, error)
+	// MarkLeader updates the local node's registration to flag it as the current leader, purely
+	// for observability (e.g. so `consul catalog` or `etcdctl get` shows who's in charge).
+	MarkLeader(ctx context.Context, nodeID string, isLeader bool) error
+}
@@ -0,0 +1,159 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package bootstrap
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	consulapi "github.com/hashicorp/consul/api"
+)
+
+// consulNode is what each node stores as the value under its KV registration.
+type consulNode struct {
+	RaftAddr string `json:"raft_addr"`
+	Leader   bool   `json:"leader"`
+}
+
+// consulKV is the slice of *consulapi.KV that ConsulDiscoverer needs, narrowed so tests can
+// inject a fake KV store without a real Consul agent.
+type consulKV interface {
+	Acquire(p *consulapi.KVPair, q *consulapi.WriteOptions) (bool, *consulapi.WriteMeta, error)
+	Get(key string, q *consulapi.QueryOptions) (*consulapi.KVPair, *consulapi.QueryMeta, error)
+	Put(p *consulapi.KVPair, q *consulapi.WriteOptions) (*consulapi.WriteMeta, error)
+	Delete(key string, w *consulapi.WriteOptions) (*consulapi.WriteMeta, error)
+	List(prefix string, q *consulapi.QueryOptions) (consulapi.KVPairs, *consulapi.QueryMeta, error)
+}
+
+// consulSession is the slice of *consulapi.Session that ConsulDiscoverer needs, narrowed so
+// tests can inject a fake session manager without a real Consul agent.
+type consulSession interface {
+	Create(entry *consulapi.SessionEntry, q *consulapi.WriteOptions) (string, *consulapi.WriteMeta, error)
+	Destroy(id string, q *consulapi.WriteOptions) (*consulapi.WriteMeta, error)
+}
+
+// ConsulDiscoverer registers and discovers peers under a KV prefix in Consul, backed by a
+// session so a node's entry is automatically removed if it dies without deregistering.
+type ConsulDiscoverer struct {
+	kv      consulKV
+	session consulSession
+
+	prefix      string
+	sessionTTL  string
+	sessionID   string
+	sessionName string
+}
+
+// NewConsulDiscoverer returns a *ConsulDiscoverer storing registrations under
+// fmt.Sprintf("%s/<nodeID>", prefix).
+func NewConsulDiscoverer(client *consulapi.Client, prefix string) *ConsulDiscoverer {
+	return &ConsulDiscoverer{
+		kv: client.KV(), session: client.Session(),
+		prefix: strings.TrimSuffix(prefix, "/"), sessionTTL: "30s",
+	}
+}
+
+func (c *ConsulDiscoverer) key(nodeID string) string {
+	return fmt.Sprintf("%s/%s", c.prefix, nodeID)
+}
+
+// Register creates a session-backed KV entry for nodeID so it disappears automatically if the
+// node dies without deregistering.
+func (c *ConsulDiscoverer) Register(ctx context.Context, nodeID, raftAddr string) error {
+	session, _, err := c.session.Create(&consulapi.SessionEntry{
+		Name:      fmt.Sprintf("weaviate-bootstrap-%s", nodeID),
+		TTL:       c.sessionTTL,
+		Behavior:  consulapi.SessionBehaviorDelete,
+		LockDelay: 0,
+	}, nil)
+	if err != nil {
+		return fmt.Errorf("create consul session for %s: %w", nodeID, err)
+	}
+	c.sessionID = session
+	c.sessionName = nodeID
+
+	value, err := json.Marshal(consulNode{RaftAddr: raftAddr})
+	if err != nil {
+		return fmt.Errorf("marshal consul registration for %s: %w", nodeID, err)
+	}
+
+	ok, _, err := c.kv.Acquire(&consulapi.KVPair{
+		Key:     c.key(nodeID),
+		Value:   value,
+		Session: session,
+	}, nil)
+	if err != nil {
+		return fmt.Errorf("register %s in consul: %w", nodeID, err)
+	}
+	if !ok {
+		return fmt.Errorf("register %s in consul: key already held by another session", nodeID)
+	}
+	return nil
+}
+
+// Deregister releases the KV entry and destroys the backing session.
+func (c *ConsulDiscoverer) Deregister(ctx context.Context, nodeID string) error {
+	if c.sessionID == "" {
+		return nil
+	}
+	if _, err := c.kv.Delete(c.key(nodeID), nil); err != nil {
+		return fmt.Errorf("deregister %s from consul: %w", nodeID, err)
+	}
+	_, err := c.session.Destroy(c.sessionID, nil)
+	return err
+}
+
+// MarkLeader re-writes the local node's registration with Leader set, purely for observability.
+func (c *ConsulDiscoverer) MarkLeader(ctx context.Context, nodeID string, isLeader bool) error {
+	pair, _, err := c.kv.Get(c.key(nodeID), nil)
+	if err != nil {
+		return fmt.Errorf("read consul registration for %s: %w", nodeID, err)
+	}
+	if pair == nil {
+		return fmt.Errorf("mark leader: %s is not registered", nodeID)
+	}
+
+	var node consulNode
+	if err := json.Unmarshal(pair.Value, &node); err != nil {
+		return fmt.Errorf("unmarshal consul registration for %s: %w", nodeID, err)
+	}
+	node.Leader = isLeader
+
+	value, err := json.Marshal(node)
+	if err != nil {
+		return err
+	}
+	pair.Value = value
+	_, err = c.kv.Put(pair, nil)
+	return err
+}
+
+// Discover lists every node currently registered under prefix.
+func (c *ConsulDiscoverer) Discover(ctx context.Context) (map[string]string, error) {
+	pairs, _, err := c.kv.List(c.prefix, nil)
+	if err != nil {
+		return nil, fmt.Errorf("list consul registrations under %s: %w", c.prefix, err)
+	}
+
+	nodes := make(map[string]string, len(pairs))
+	for _, pair := range pairs {
+		nodeID := strings.TrimPrefix(pair.Key, c.prefix+"/")
+		var node consulNode
+		if err := json.Unmarshal(pair.Value, &node); err != nil {
+			continue
+		}
+		nodes[nodeID] = node.RaftAddr
+	}
+	return nodes, nil
+}
@@ -0,0 +1,134 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package bootstrap
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	consulapi "github.com/hashicorp/consul/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeConsulKV is an in-memory stand-in for *consulapi.KV.
+type fakeConsulKV struct {
+	pairs map[string]*consulapi.KVPair
+}
+
+func newFakeConsulKV() *fakeConsulKV {
+	return &fakeConsulKV{pairs: map[string]*consulapi.KVPair{}}
+}
+
+func (f *fakeConsulKV) Acquire(p *consulapi.KVPair, _ *consulapi.WriteOptions) (bool, *consulapi.WriteMeta, error) {
+	f.pairs[p.Key] = p
+	return true, nil, nil
+}
+
+func (f *fakeConsulKV) Get(key string, _ *consulapi.QueryOptions) (*consulapi.KVPair, *consulapi.QueryMeta, error) {
+	return f.pairs[key], nil, nil
+}
+
+func (f *fakeConsulKV) Put(p *consulapi.KVPair, _ *consulapi.WriteOptions) (*consulapi.WriteMeta, error) {
+	f.pairs[p.Key] = p
+	return nil, nil
+}
+
+func (f *fakeConsulKV) Delete(key string, _ *consulapi.WriteOptions) (*consulapi.WriteMeta, error) {
+	delete(f.pairs, key)
+	return nil, nil
+}
+
+func (f *fakeConsulKV) List(prefix string, _ *consulapi.QueryOptions) (consulapi.KVPairs, *consulapi.QueryMeta, error) {
+	var pairs consulapi.KVPairs
+	for key, pair := range f.pairs {
+		if len(key) >= len(prefix) && key[:len(prefix)] == prefix {
+			pairs = append(pairs, pair)
+		}
+	}
+	return pairs, nil, nil
+}
+
+// fakeConsulSession is an in-memory stand-in for *consulapi.Session.
+type fakeConsulSession struct {
+	created   int
+	destroyed []string
+}
+
+func (f *fakeConsulSession) Create(_ *consulapi.SessionEntry, _ *consulapi.WriteOptions) (string, *consulapi.WriteMeta, error) {
+	f.created++
+	return "session-1", nil, nil
+}
+
+func (f *fakeConsulSession) Destroy(id string, _ *consulapi.WriteOptions) (*consulapi.WriteMeta, error) {
+	f.destroyed = append(f.destroyed, id)
+	return nil, nil
+}
+
+func newTestConsulDiscoverer(kv consulKV, session consulSession, prefix string) *ConsulDiscoverer {
+	return &ConsulDiscoverer{kv: kv, session: session, prefix: prefix, sessionTTL: "30s"}
+}
+
+func TestConsulDiscovererRegisterThenDiscoverReturnsTheRegisteredNode(t *testing.T) {
+	kv := newFakeConsulKV()
+	session := &fakeConsulSession{}
+	d := newTestConsulDiscoverer(kv, session, "weaviate/bootstrap")
+
+	require.NoError(t, d.Register(context.Background(), "node1", "127.0.0.1:8300"))
+	assert.Equal(t, 1, session.created)
+
+	nodes, err := d.Discover(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"node1": "127.0.0.1:8300"}, nodes)
+}
+
+func TestConsulDiscovererDeregisterRemovesKeyAndDestroysSession(t *testing.T) {
+	kv := newFakeConsulKV()
+	session := &fakeConsulSession{}
+	d := newTestConsulDiscoverer(kv, session, "weaviate/bootstrap")
+
+	require.NoError(t, d.Register(context.Background(), "node1", "127.0.0.1:8300"))
+	require.NoError(t, d.Deregister(context.Background(), "node1"))
+
+	assert.Equal(t, []string{"session-1"}, session.destroyed)
+	nodes, err := d.Discover(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, nodes)
+}
+
+func TestConsulDiscovererDeregisterWithoutRegisterIsANoOp(t *testing.T) {
+	d := newTestConsulDiscoverer(newFakeConsulKV(), &fakeConsulSession{}, "weaviate/bootstrap")
+	require.NoError(t, d.Deregister(context.Background(), "node1"))
+}
+
+func TestConsulDiscovererMarkLeaderUpdatesStoredRegistration(t *testing.T) {
+	kv := newFakeConsulKV()
+	session := &fakeConsulSession{}
+	d := newTestConsulDiscoverer(kv, session, "weaviate/bootstrap")
+
+	require.NoError(t, d.Register(context.Background(), "node1", "127.0.0.1:8300"))
+	require.NoError(t, d.MarkLeader(context.Background(), "node1", true))
+
+	pair, _, err := kv.Get("weaviate/bootstrap/node1", nil)
+	require.NoError(t, err)
+	var node consulNode
+	require.NoError(t, json.Unmarshal(pair.Value, &node))
+	assert.True(t, node.Leader)
+	assert.Equal(t, "127.0.0.1:8300", node.RaftAddr)
+}
+
+func TestConsulDiscovererMarkLeaderErrorsIfNotRegistered(t *testing.T) {
+	d := newTestConsulDiscoverer(newFakeConsulKV(), &fakeConsulSession{}, "weaviate/bootstrap")
+	err := d.MarkLeader(context.Background(), "node1", true)
+	require.Error(t, err)
+}
@@ -0,0 +1,78 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package bootstrap
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+)
+
+// srvLookuper is the slice of *net.Resolver that DNSDiscoverer needs, narrowed so tests can
+// inject a fake SRV answer without standing up a real DNS server.
+type srvLookuper interface {
+	LookupSRV(ctx context.Context, service, proto, name string) (cname string, addrs []*net.SRV, err error)
+}
+
+// DNSDiscoverer discovers peers via a DNS-SRV record, as commonly used with Kubernetes
+// headless services (e.g. "_raft._tcp.weaviate-headless.default.svc.cluster.local").
+// Registration is a no-op since membership is derived entirely from what the DNS server
+// returns: there's nothing for a node to push.
+type DNSDiscoverer struct {
+	// Service, Proto and Name make up the SRV record to query, e.g. service="raft",
+	// proto="tcp", name="weaviate-headless.default.svc.cluster.local".
+	Service string
+	Proto   string
+	Name    string
+
+	// Resolver defaults to net.DefaultResolver; overridable in tests.
+	Resolver srvLookuper
+}
+
+// NewDNSDiscoverer returns a *DNSDiscoverer that resolves peers from the given SRV record.
+func NewDNSDiscoverer(service, proto, name string) *DNSDiscoverer {
+	return &DNSDiscoverer{Service: service, Proto: proto, Name: name, Resolver: net.DefaultResolver}
+}
+
+func (d *DNSDiscoverer) Register(ctx context.Context, nodeID, raftAddr string) error {
+	return nil
+}
+
+func (d *DNSDiscoverer) Deregister(ctx context.Context, nodeID string) error {
+	return nil
+}
+
+func (d *DNSDiscoverer) MarkLeader(ctx context.Context, nodeID string, isLeader bool) error {
+	return nil
+}
+
+// Discover resolves the configured SRV record and returns each target as a node keyed by its
+// resolved "host:port" address, since DNS-SRV carries no notion of a stable node ID.
+func (d *DNSDiscoverer) Discover(ctx context.Context) (map[string]string, error) {
+	resolver := d.Resolver
+	if resolver == nil {
+		resolver = net.DefaultResolver
+	}
+
+	_, srvs, err := resolver.LookupSRV(ctx, d.Service, d.Proto, d.Name)
+	if err != nil {
+		return nil, fmt.Errorf("lookup SRV _%s._%s.%s: %w", d.Service, d.Proto, d.Name, err)
+	}
+
+	nodes := make(map[string]string, len(srvs))
+	for _, srv := range srvs {
+		addr := net.JoinHostPort(srv.Target, strconv.Itoa(int(srv.Port)))
+		nodes[addr] = addr
+	}
+	return nodes, nil
+}
@@ -0,0 +1,65 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package bootstrap
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSRVLookuper struct {
+	srvs []*net.SRV
+	err  error
+}
+
+func (f *fakeSRVLookuper) LookupSRV(ctx context.Context, service, proto, name string) (string, []*net.SRV, error) {
+	if f.err != nil {
+		return "", nil, f.err
+	}
+	return "", f.srvs, nil
+}
+
+func TestDNSDiscovererDiscoverReturnsEachSRVTargetAsANode(t *testing.T) {
+	d := NewDNSDiscoverer("raft", "tcp", "weaviate-headless.default.svc.cluster.local")
+	d.Resolver = &fakeSRVLookuper{srvs: []*net.SRV{
+		{Target: "weaviate-0.weaviate-headless.default.svc.cluster.local.", Port: 8300},
+		{Target: "weaviate-1.weaviate-headless.default.svc.cluster.local.", Port: 8300},
+	}}
+
+	nodes, err := d.Discover(context.Background())
+	require.NoError(t, err)
+	require.Len(t, nodes, 2)
+	assert.Equal(t, "weaviate-0.weaviate-headless.default.svc.cluster.local.:8300",
+		nodes["weaviate-0.weaviate-headless.default.svc.cluster.local.:8300"])
+	assert.Equal(t, "weaviate-1.weaviate-headless.default.svc.cluster.local.:8300",
+		nodes["weaviate-1.weaviate-headless.default.svc.cluster.local.:8300"])
+}
+
+func TestDNSDiscovererDiscoverReturnsErrorOnLookupFailure(t *testing.T) {
+	d := NewDNSDiscoverer("raft", "tcp", "weaviate-headless.default.svc.cluster.local")
+	d.Resolver = &fakeSRVLookuper{err: assert.AnError}
+
+	_, err := d.Discover(context.Background())
+	require.Error(t, err)
+}
+
+func TestDNSDiscovererRegisterDeregisterMarkLeaderAreNoOps(t *testing.T) {
+	d := NewDNSDiscoverer("raft", "tcp", "weaviate-headless.default.svc.cluster.local")
+
+	require.NoError(t, d.Register(context.Background(), "node1", "127.0.0.1:8300"))
+	require.NoError(t, d.MarkLeader(context.Background(), "node1", true))
+	require.NoError(t, d.Deregister(context.Background(), "node1"))
+}
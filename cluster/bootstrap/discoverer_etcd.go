@@ -0,0 +1,118 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package bootstrap
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// etcdLeaseKV is the slice of *clientv3.Client that EtcdDiscoverer needs, narrowed so tests can
+// inject a fake client without a real etcd cluster. *clientv3.Client embeds both clientv3.KV and
+// clientv3.Lease, so it satisfies this interface as-is.
+type etcdLeaseKV interface {
+	clientv3.KV
+	clientv3.Lease
+}
+
+// EtcdDiscoverer registers and discovers peers under a key prefix in etcd, backed by a lease so
+// a node's key expires automatically if it dies without deregistering.
+type EtcdDiscoverer struct {
+	client    etcdLeaseKV
+	prefix    string
+	leaseTTL  int64
+	leaseID   clientv3.LeaseID
+	keepAlive <-chan *clientv3.LeaseKeepAliveResponse
+}
+
+// NewEtcdDiscoverer returns an *EtcdDiscoverer storing registrations under
+// fmt.Sprintf("%s/<nodeID>", prefix), refreshed via a leaseTTLSeconds-second lease.
+func NewEtcdDiscoverer(client *clientv3.Client, prefix string, leaseTTLSeconds int64) *EtcdDiscoverer {
+	return &EtcdDiscoverer{client: client, prefix: strings.TrimSuffix(prefix, "/"), leaseTTL: leaseTTLSeconds}
+}
+
+func (e *EtcdDiscoverer) key(nodeID string) string {
+	return fmt.Sprintf("%s/%s", e.prefix, nodeID)
+}
+
+// Register grants a lease, puts the registration under it, and starts keeping the lease alive
+// in the background so the key disappears automatically if the process dies without
+// deregistering. The keep-alive is not tied to ctx: it runs until the lease is revoked or the
+// client is closed.
+func (e *EtcdDiscoverer) Register(ctx context.Context, nodeID, raftAddr string) error {
+	lease, err := e.client.Grant(ctx, e.leaseTTL)
+	if err != nil {
+		return fmt.Errorf("grant etcd lease for %s: %w", nodeID, err)
+	}
+	e.leaseID = lease.ID
+
+	if _, err := e.client.Put(ctx, e.key(nodeID), raftAddr, clientv3.WithLease(lease.ID)); err != nil {
+		return fmt.Errorf("register %s in etcd: %w", nodeID, err)
+	}
+
+	keepAlive, err := e.client.KeepAlive(context.Background(), lease.ID)
+	if err != nil {
+		return fmt.Errorf("keep alive etcd lease for %s: %w", nodeID, err)
+	}
+	e.keepAlive = keepAlive
+	return nil
+}
+
+// Deregister revokes the lease, which deletes the registration immediately rather than waiting
+// for it to expire.
+func (e *EtcdDiscoverer) Deregister(ctx context.Context, nodeID string) error {
+	if e.leaseID == 0 {
+		return nil
+	}
+	_, err := e.client.Revoke(ctx, e.leaseID)
+	return err
+}
+
+// MarkLeader re-puts the local node's registration with a "leader=" suffix, purely for
+// observability via `etcdctl get --prefix`.
+func (e *EtcdDiscoverer) MarkLeader(ctx context.Context, nodeID string, isLeader bool) error {
+	resp, err := e.client.Get(ctx, e.key(nodeID))
+	if err != nil {
+		return fmt.Errorf("read etcd registration for %s: %w", nodeID, err)
+	}
+	if len(resp.Kvs) == 0 {
+		return fmt.Errorf("mark leader: %s is not registered", nodeID)
+	}
+
+	raftAddr := strings.SplitN(string(resp.Kvs[0].Value), "|leader=", 2)[0]
+	value := raftAddr
+	if isLeader {
+		value = fmt.Sprintf("%s|leader=true", raftAddr)
+	}
+
+	_, err = e.client.Put(ctx, e.key(nodeID), value, clientv3.WithLease(e.leaseID))
+	return err
+}
+
+// Discover lists every node currently registered under prefix.
+func (e *EtcdDiscoverer) Discover(ctx context.Context) (map[string]string, error) {
+	resp, err := e.client.Get(ctx, e.prefix+"/", clientv3.WithPrefix())
+	if err != nil {
+		return nil, fmt.Errorf("list etcd registrations under %s: %w", e.prefix, err)
+	}
+
+	nodes := make(map[string]string, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		nodeID := strings.TrimPrefix(string(kv.Key), e.prefix+"/")
+		raftAddr := strings.SplitN(string(kv.Value), "|leader=", 2)[0]
+		nodes[nodeID] = raftAddr
+	}
+	return nodes, nil
+}
@@ -0,0 +1,165 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package bootstrap
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// fakeEtcdLeaseKV is an in-memory stand-in for the KV+Lease slice of *clientv3.Client that
+// EtcdDiscoverer needs.
+type fakeEtcdLeaseKV struct {
+	kv        map[string]string
+	nextLease clientv3.LeaseID
+	revoked   map[clientv3.LeaseID]bool
+}
+
+func newFakeEtcdLeaseKV() *fakeEtcdLeaseKV {
+	return &fakeEtcdLeaseKV{kv: map[string]string{}, revoked: map[clientv3.LeaseID]bool{}}
+}
+
+func (f *fakeEtcdLeaseKV) Put(ctx context.Context, key, val string, opts ...clientv3.OpOption) (*clientv3.PutResponse, error) {
+	f.kv[key] = val
+	return &clientv3.PutResponse{}, nil
+}
+
+// Get only needs to support the two query shapes EtcdDiscoverer actually issues: an exact-key
+// lookup (MarkLeader) and a prefix scan ending in "/" (Discover). Real
+// clientv3.WithPrefix() semantics aren't replicated beyond that.
+func (f *fakeEtcdLeaseKV) Get(ctx context.Context, key string, opts ...clientv3.OpOption) (*clientv3.GetResponse, error) {
+	prefix := strings.HasSuffix(key, "/")
+
+	resp := &clientv3.GetResponse{}
+	if prefix {
+		for k, v := range f.kv {
+			if strings.HasPrefix(k, key) {
+				resp.Kvs = append(resp.Kvs, &mvccpb.KeyValue{Key: []byte(k), Value: []byte(v)})
+			}
+		}
+		return resp, nil
+	}
+
+	if v, ok := f.kv[key]; ok {
+		resp.Kvs = append(resp.Kvs, &mvccpb.KeyValue{Key: []byte(key), Value: []byte(v)})
+	}
+	return resp, nil
+}
+
+func (f *fakeEtcdLeaseKV) Delete(ctx context.Context, key string, opts ...clientv3.OpOption) (*clientv3.DeleteResponse, error) {
+	delete(f.kv, key)
+	return &clientv3.DeleteResponse{}, nil
+}
+
+func (f *fakeEtcdLeaseKV) Compact(ctx context.Context, rev int64, opts ...clientv3.CompactOption) (*clientv3.CompactResponse, error) {
+	return &clientv3.CompactResponse{}, nil
+}
+
+func (f *fakeEtcdLeaseKV) Do(ctx context.Context, op clientv3.Op) (clientv3.OpResponse, error) {
+	return clientv3.OpResponse{}, nil
+}
+
+func (f *fakeEtcdLeaseKV) Txn(ctx context.Context) clientv3.Txn {
+	return nil
+}
+
+func (f *fakeEtcdLeaseKV) Grant(ctx context.Context, ttl int64) (*clientv3.LeaseGrantResponse, error) {
+	f.nextLease++
+	return &clientv3.LeaseGrantResponse{ID: f.nextLease, TTL: ttl}, nil
+}
+
+func (f *fakeEtcdLeaseKV) Revoke(ctx context.Context, id clientv3.LeaseID) (*clientv3.LeaseRevokeResponse, error) {
+	f.revoked[id] = true
+	for k := range f.kv {
+		delete(f.kv, k)
+	}
+	return &clientv3.LeaseRevokeResponse{}, nil
+}
+
+func (f *fakeEtcdLeaseKV) TimeToLive(ctx context.Context, id clientv3.LeaseID, opts ...clientv3.LeaseOption) (*clientv3.LeaseTimeToLiveResponse, error) {
+	return &clientv3.LeaseTimeToLiveResponse{ID: id}, nil
+}
+
+func (f *fakeEtcdLeaseKV) Leases(ctx context.Context) (*clientv3.LeaseLeasesResponse, error) {
+	return &clientv3.LeaseLeasesResponse{}, nil
+}
+
+func (f *fakeEtcdLeaseKV) KeepAlive(ctx context.Context, id clientv3.LeaseID) (<-chan *clientv3.LeaseKeepAliveResponse, error) {
+	ch := make(chan *clientv3.LeaseKeepAliveResponse)
+	close(ch)
+	return ch, nil
+}
+
+func (f *fakeEtcdLeaseKV) KeepAliveOnce(ctx context.Context, id clientv3.LeaseID) (*clientv3.LeaseKeepAliveResponse, error) {
+	return &clientv3.LeaseKeepAliveResponse{ID: id}, nil
+}
+
+func (f *fakeEtcdLeaseKV) Close() error { return nil }
+
+func newTestEtcdDiscoverer(client etcdLeaseKV, prefix string) *EtcdDiscoverer {
+	return &EtcdDiscoverer{client: client, prefix: prefix, leaseTTL: 30}
+}
+
+func TestEtcdDiscovererRegisterThenDiscoverReturnsTheRegisteredNode(t *testing.T) {
+	client := newFakeEtcdLeaseKV()
+	d := newTestEtcdDiscoverer(client, "weaviate/bootstrap")
+
+	require.NoError(t, d.Register(context.Background(), "node1", "127.0.0.1:8300"))
+
+	nodes, err := d.Discover(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"node1": "127.0.0.1:8300"}, nodes)
+}
+
+func TestEtcdDiscovererDeregisterRevokesLeaseAndRemovesKey(t *testing.T) {
+	client := newFakeEtcdLeaseKV()
+	d := newTestEtcdDiscoverer(client, "weaviate/bootstrap")
+
+	require.NoError(t, d.Register(context.Background(), "node1", "127.0.0.1:8300"))
+	require.NoError(t, d.Deregister(context.Background(), "node1"))
+
+	assert.True(t, client.revoked[d.leaseID])
+	nodes, err := d.Discover(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, nodes)
+}
+
+func TestEtcdDiscovererDeregisterWithoutRegisterIsANoOp(t *testing.T) {
+	d := newTestEtcdDiscoverer(newFakeEtcdLeaseKV(), "weaviate/bootstrap")
+	require.NoError(t, d.Deregister(context.Background(), "node1"))
+}
+
+func TestEtcdDiscovererMarkLeaderAppendsLeaderSuffix(t *testing.T) {
+	client := newFakeEtcdLeaseKV()
+	d := newTestEtcdDiscoverer(client, "weaviate/bootstrap")
+
+	require.NoError(t, d.Register(context.Background(), "node1", "127.0.0.1:8300"))
+	require.NoError(t, d.MarkLeader(context.Background(), "node1", true))
+
+	assert.Equal(t, "127.0.0.1:8300|leader=true", client.kv["weaviate/bootstrap/node1"])
+
+	nodes, err := d.Discover(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "127.0.0.1:8300", nodes["node1"], "Discover must strip the leader suffix")
+}
+
+func TestEtcdDiscovererMarkLeaderErrorsIfNotRegistered(t *testing.T) {
+	d := newTestEtcdDiscoverer(newFakeEtcdLeaseKV(), "weaviate/bootstrap")
+	err := d.MarkLeader(context.Background(), "node1", true)
+	require.Error(t, err)
+}
@@ -0,0 +1,132 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package bootstrap
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/memberlist"
+	"github.com/sirupsen/logrus"
+)
+
+// ClusterNodeEventHandler lets other subsystems (sharding, replication, ...) react to a peer
+// joining or leaving the gossip cluster before that change is reflected in Raft membership.
+// This closes the gap where a briefly-partitioned node is still considered part of the cluster
+// by higher layers long after gossip noticed it was gone.
+type ClusterNodeEventHandler interface {
+	// OnNodeJoin is called with the joining node's name and its gossip (memberlist) address as
+	// "host:port"; that is not the node's raft address.
+	OnNodeJoin(nodeID, addr string)
+	OnNodeLeave(nodeID string)
+}
+
+// GossipResolver implements ClusterStateReader by returning the union of a static seed list
+// and whatever memberlist currently believes is alive. It is meant to back Joiner.Do so that
+// ResolveRemoteNodes picks up fresh addresses as nodes join or leave, instead of only ever
+// seeing the membership known at process start.
+type GossipResolver struct {
+	list      *memberlist.Memberlist
+	localName string
+
+	mu       sync.RWMutex
+	seeds    map[string]string // node name -> host, statically configured
+	handlers []ClusterNodeEventHandler
+}
+
+// NewGossipResolver wraps list, an already-created memberlist.Memberlist, adding seeds as a
+// fallback for nodes gossip hasn't (yet) discovered.
+func NewGossipResolver(list *memberlist.Memberlist, localName string, seeds map[string]string) *GossipResolver {
+	return &GossipResolver{list: list, localName: localName, seeds: seeds}
+}
+
+// AddEventHandler registers h to be notified of future join/leave events. Handlers are invoked
+// synchronously from NotifyJoin/NotifyLeave, so they must not block.
+func (g *GossipResolver) AddEventHandler(h ClusterNodeEventHandler) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.handlers = append(g.handlers, h)
+}
+
+// NotifyJoin implements memberlist.EventDelegate.
+func (g *GossipResolver) NotifyJoin(node *memberlist.Node) {
+	g.mu.RLock()
+	handlers := append([]ClusterNodeEventHandler(nil), g.handlers...)
+	g.mu.RUnlock()
+	for _, h := range handlers {
+		h.OnNodeJoin(node.Name, node.Address())
+	}
+}
+
+// NotifyLeave implements memberlist.EventDelegate.
+func (g *GossipResolver) NotifyLeave(node *memberlist.Node) {
+	g.mu.RLock()
+	handlers := append([]ClusterNodeEventHandler(nil), g.handlers...)
+	g.mu.RUnlock()
+	for _, h := range handlers {
+		h.OnNodeLeave(node.Name)
+	}
+}
+
+// NotifyUpdate implements memberlist.EventDelegate; node metadata updates don't change cluster
+// membership so there's nothing to react to here.
+func (g *GossipResolver) NotifyUpdate(node *memberlist.Node) {}
+
+func (g *GossipResolver) NodeAddress(id string) string {
+	host, _ := g.NodeHostname(id)
+	return host
+}
+
+func (g *GossipResolver) NodeHostname(nodeName string) (string, bool) {
+	for _, member := range g.list.Members() {
+		if member.Name == nodeName {
+			return member.Addr.String(), true
+		}
+	}
+
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	host, ok := g.seeds[nodeName]
+	return host, ok
+}
+
+func (g *GossipResolver) LocalName() string {
+	return g.localName
+}
+
+// AllClusterMembers returns the union of live gossip members and the statically configured
+// seeds, live members taking precedence when both know about the same node name.
+func (g *GossipResolver) AllClusterMembers(raftPort int) map[string]string {
+	result := make(map[string]string)
+
+	g.mu.RLock()
+	for name, host := range g.seeds {
+		result[name] = fmt.Sprintf("%s:%d", host, raftPort)
+	}
+	g.mu.RUnlock()
+
+	for _, member := range g.list.Members() {
+		result[member.Name] = fmt.Sprintf("%s:%d", member.Addr.String(), raftPort)
+	}
+	return result
+}
+
+// Join joins the gossip cluster using seedAddrs (typically the static seed list, or addresses
+// returned by a Discoverer), logging how many seeds were contacted successfully.
+func (g *GossipResolver) Join(seedAddrs []string, lg *logrus.Logger) error {
+	n, err := g.list.Join(seedAddrs)
+	if err != nil {
+		return fmt.Errorf("join gossip cluster via %v: %w", seedAddrs, err)
+	}
+	lg.WithField("contacted", n).WithField("seeds", len(seedAddrs)).Info("joined gossip cluster")
+	return nil
+}
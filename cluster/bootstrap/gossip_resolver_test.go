@@ -0,0 +1,50 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package bootstrap
+
+import (
+	"testing"
+
+	"github.com/hashicorp/memberlist"
+	"github.com/stretchr/testify/assert"
+)
+
+type recordingEventHandler struct {
+	joined []string
+	left   []string
+}
+
+func (r *recordingEventHandler) OnNodeJoin(nodeID, raftAddr string) {
+	r.joined = append(r.joined, nodeID)
+}
+
+func (r *recordingEventHandler) OnNodeLeave(nodeID string) {
+	r.left = append(r.left, nodeID)
+}
+
+func TestGossipResolverFansOutJoinAndLeaveEvents(t *testing.T) {
+	g := &GossipResolver{localName: "node1", seeds: map[string]string{}}
+
+	first := &recordingEventHandler{}
+	second := &recordingEventHandler{}
+	g.AddEventHandler(first)
+	g.AddEventHandler(second)
+
+	node2 := &memberlist.Node{Name: "node2"}
+	g.NotifyJoin(node2)
+	g.NotifyLeave(node2)
+
+	assert.Equal(t, []string{"node2"}, first.joined)
+	assert.Equal(t, []string{"node2"}, second.joined)
+	assert.Equal(t, []string{"node2"}, first.left)
+	assert.Equal(t, []string{"node2"}, second.left)
+}
@@ -14,6 +14,7 @@ package bootstrap
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -31,6 +32,11 @@ type Joiner struct {
 	localNodeID   string
 	voter         bool
 	peerJoiner    PeerJoiner
+	discoverer    Discoverer
+	retryConfig   JoinRetryConfig
+
+	resolver      ClusterStateReader
+	serverPortMap map[string]int
 }
 
 // NewJoiner returns a *Joiner configured with localNodeID, localRaftAddr and voter.
@@ -40,9 +46,34 @@ func NewJoiner(peerJoiner PeerJoiner, localNodeID string, localRaftAddr string,
 		localNodeID:   localNodeID,
 		localRaftAddr: localRaftAddr,
 		voter:         voter,
+		retryConfig:   DefaultJoinRetryConfig(),
 	}
 }
 
+// WithDiscoverer configures j to augment remoteNodes with peers found via d on every sweep, in
+// addition to whatever remoteNodes is passed to Do. It returns j for chaining.
+func (j *Joiner) WithDiscoverer(d Discoverer) *Joiner {
+	j.discoverer = d
+	return j
+}
+
+// WithRetryConfig overrides the default retry/backoff policy used between sweeps. It returns j
+// for chaining.
+func (j *Joiner) WithRetryConfig(c JoinRetryConfig) *Joiner {
+	j.retryConfig = c
+	return j
+}
+
+// WithResolver configures j to refresh remoteNodes from resolver (via ResolveRemoteNodes and
+// serverPortMap) at the start of every sweep, so peers that appear mid-retry are picked up
+// instead of only ever seeing the remoteNodes passed to the initial Do call. It returns j for
+// chaining.
+func (j *Joiner) WithResolver(resolver ClusterStateReader, serverPortMap map[string]int) *Joiner {
+	j.resolver = resolver
+	j.serverPortMap = serverPortMap
+	return j
+}
+
 // Do will attempt to send to any nodes in remoteNodes a JoinPeerRequest for j.localNodeID with the address j.localRaftAddr.
 // Will join as voter if j.voter is true, non voter otherwise.
 // Returns the leader address if a cluster was joined or an error otherwise.
@@ -57,66 +88,117 @@ func (j *Joiner) Do(ctx context.Context, lg *logrus.Logger, remoteNodes map[stri
 		defer span.Finish()
 	}
 
-	var resp *cmd.JoinPeerResponse
-	var err error
-	var errors []string
 	req := &cmd.JoinPeerRequest{Id: j.localNodeID, Address: j.localRaftAddr, Voter: j.voter}
+	// errByNode keeps only the most recent error per node across all sweeps, so a flaky node
+	// that fails the same way on every sweep doesn't bloat the final error message.
+	errByNode := map[string]string{}
 
-	// For each server, try to join.
-	// If we have no error then we have a leader
-	// If we have an error check for err == NOT_FOUND and leader != "" -> we contacted a non-leader node part of the
-	// cluster, let's join the leader.
-	// If no server allows us to join a cluster, return an error
-	// For each server, try to join with retry logic and backoff.
-	// The gRPC client has its own retry policy, but we add additional backoff
-	// between different nodes to allow services to start up.
-	for name, addr := range remoteNodes {
-		if name == j.localNodeID {
-			continue
-		}
+	// Sweep over remoteNodes repeatedly with a growing backoff between sweeps, instead of
+	// giving up after a single pass. This matters during a rolling restart, where every peer
+	// can be briefly unreachable at the same time.
+	for attempt := 1; j.retryConfig.MaxAttempts == 0 || attempt <= j.retryConfig.MaxAttempts; attempt++ {
+		sweepNodes := j.refreshRemoteNodes(ctx, lg, remoteNodes)
 
-		lg.WithFields(logrus.Fields{
-			"remoteNodes": remoteNodes,
-			"node":        name,
-			"address":     addr,
-		}).Info("attempting to join")
-
-		// Try to join this node - gRPC client will handle retries for this specific node
-		resp, err = j.peerJoiner.Join(ctx, addr, req)
-		if err == nil {
-			return addr, nil
-		}
+		for name, addr := range sweepNodes {
+			if name == j.localNodeID {
+				continue
+			}
 
-		// Log the error but don't immediately give up
-		st := status.Convert(err)
+			lg.WithFields(logrus.Fields{
+				"remoteNodes": sweepNodes,
+				"node":        name,
+				"address":     addr,
+				"attempt":     attempt,
+			}).Info("attempting to join")
 
-		// Get the leader from response and if not empty try to join it
-		if leader := resp.GetLeader(); st.Code() == codes.ResourceExhausted && leader != "" {
-			lg.WithField("leader", leader).Info("attempting to join leader")
-			_, err = j.peerJoiner.Join(ctx, leader, req)
+			resp, err := j.peerJoiner.Join(ctx, addr, req)
 			if err == nil {
-				return leader, nil
+				joinAttemptsTotal.WithLabelValues(name, "success").Inc()
+				return addr, nil
+			}
+
+			st := status.Convert(err)
+
+			// Get the leader from response and if not empty try to join it
+			if leader := resp.GetLeader(); st.Code() == codes.ResourceExhausted && leader != "" {
+				lg.WithField("leader", leader).Info("attempting to join leader")
+				_, leaderErr := j.peerJoiner.Join(ctx, leader, req)
+				if leaderErr == nil {
+					joinAttemptsTotal.WithLabelValues(name, "success").Inc()
+					return leader, nil
+				}
+				lg.WithField("leader", leader).WithError(leaderErr).Info("attempted to follow to leader and failed")
+				joinAttemptsTotal.WithLabelValues(name, "error").Inc()
+				errByNode[name] = fmt.Sprintf("leader(%s): %v", leader, leaderErr)
+			} else {
+				joinAttemptsTotal.WithLabelValues(name, "error").Inc()
+				errByNode[name] = fmt.Sprintf("%s(%s): %v", name, addr, err)
 			}
-			lg.WithField("leader", leader).WithError(err).Info("attempted to follow to leader and failed")
-			errors = append(errors, fmt.Sprintf("leader(%s): %v", leader, err))
-		} else {
-			errors = append(errors, fmt.Sprintf("%s(%s): %v", name, addr, err))
 		}
 
-		// Add a small delay before trying the next node to allow services to start up
-		// This gives the gRPC retry policy time to work and prevents overwhelming
-		// nodes that might be starting up
+		// No point backing off after the last allowed sweep: there won't be another attempt to
+		// wait for, so just fall through and report the accumulated errors immediately.
+		if j.retryConfig.MaxAttempts != 0 && attempt == j.retryConfig.MaxAttempts {
+			break
+		}
+
+		wait := j.retryConfig.backoff(attempt)
+		lg.WithFields(logrus.Fields{"attempt": attempt, "backoff": wait}).
+			Info("no peer accepted join this sweep, backing off before retrying")
+
 		select {
 		case <-ctx.Done():
 			return "", ctx.Err()
-		case <-time.After(50 * time.Millisecond):
-			// Continue to next node - minimal delay for rejoin scenarios
+		case <-time.After(wait):
+			// Continue to next sweep.
 		}
 	}
 
+	errors := make([]string, 0, len(errByNode))
+	for _, msg := range errByNode {
+		errors = append(errors, msg)
+	}
+	sort.Strings(errors)
+
 	// Return a joined error message with all failed attempts
 	if len(errors) > 0 {
 		return "", fmt.Errorf("could not join a cluster from %v: %s", remoteNodes, strings.Join(errors, "; "))
 	}
 	return "", fmt.Errorf("could not join a cluster from %v", remoteNodes)
 }
+
+// refreshRemoteNodes re-resolves remoteNodes from j.resolver (if configured via WithResolver)
+// and merges in anything j.discoverer finds, so every sweep sees peers that appeared since the
+// previous one rather than only ever seeing the set Do was originally called with.
+func (j *Joiner) refreshRemoteNodes(ctx context.Context, lg *logrus.Logger, remoteNodes map[string]string) map[string]string {
+	if j.resolver != nil {
+		remoteNodes = ResolveRemoteNodes(j.resolver, j.serverPortMap)
+	}
+	return j.mergeDiscovered(ctx, lg, remoteNodes)
+}
+
+// mergeDiscovered returns remoteNodes with any peers found via j.discoverer added in, leaving
+// remoteNodes untouched if no Discoverer is configured or discovery fails (discovery is a
+// best-effort addition on top of the statically configured remoteNodes, not a replacement).
+func (j *Joiner) mergeDiscovered(ctx context.Context, lg *logrus.Logger, remoteNodes map[string]string) map[string]string {
+	if j.discoverer == nil {
+		return remoteNodes
+	}
+
+	discovered, err := j.discoverer.Discover(ctx)
+	if err != nil {
+		lg.WithError(err).Warn("peer discovery failed, falling back to statically configured nodes")
+		return remoteNodes
+	}
+
+	merged := make(map[string]string, len(remoteNodes)+len(discovered))
+	for name, addr := range remoteNodes {
+		merged[name] = addr
+	}
+	for name, addr := range discovered {
+		if _, ok := merged[name]; !ok {
+			merged[name] = addr
+		}
+	}
+	return merged
+}
@@ -0,0 +1,65 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package bootstrap
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJoinerMergeDiscoveredAddsNewPeersWithoutOverridingStaticOnes(t *testing.T) {
+	j := NewJoiner(&fakePeerJoiner{}, "node1", "127.0.0.1:8300", true)
+	j.WithDiscoverer(&fakeDiscoverer{
+		peers: map[string]string{
+			"node1": "127.0.0.1:9999", // must not override the statically configured address
+			"node2": "127.0.0.2:8300",
+		},
+	})
+
+	merged := j.mergeDiscovered(context.Background(), logrus.New(), map[string]string{"node1": "127.0.0.1:8300"})
+
+	require.Len(t, merged, 2)
+	assert.Equal(t, "127.0.0.1:8300", merged["node1"])
+	assert.Equal(t, "127.0.0.2:8300", merged["node2"])
+}
+
+func TestJoinerMergeDiscoveredFallsBackOnError(t *testing.T) {
+	j := NewJoiner(&fakePeerJoiner{}, "node1", "127.0.0.1:8300", true)
+	j.WithDiscoverer(&fakeDiscoverer{err: assert.AnError})
+
+	remoteNodes := map[string]string{"node1": "127.0.0.1:8300"}
+	merged := j.mergeDiscovered(context.Background(), logrus.New(), remoteNodes)
+
+	assert.Equal(t, remoteNodes, merged)
+}
+
+type fakeDiscoverer struct {
+	peers map[string]string
+	err   error
+}
+
+func (f *fakeDiscoverer) Register(ctx context.Context, nodeID, raftAddr string) error { return nil }
+func (f *fakeDiscoverer) Deregister(ctx context.Context, nodeID string) error        { return nil }
+func (f *fakeDiscoverer) MarkLeader(ctx context.Context, nodeID string, isLeader bool) error {
+	return nil
+}
+
+func (f *fakeDiscoverer) Discover(ctx context.Context) (map[string]string, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return f.peers, nil
+}
@@ -0,0 +1,58 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package bootstrap
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cmd "github.com/weaviate/weaviate/cluster/proto/api"
+)
+
+// alwaysFailingPeerJoiner rejects every Join, so Do exhausts every sweep allowed by
+// JoinRetryConfig.MaxAttempts and returns the accumulated error.
+type alwaysFailingPeerJoiner struct{}
+
+func (alwaysFailingPeerJoiner) Join(ctx context.Context, addr string, req *cmd.JoinPeerRequest) (*cmd.JoinPeerResponse, error) {
+	return nil, assert.AnError
+}
+
+func (alwaysFailingPeerJoiner) Ping(ctx context.Context, addr string) error { return nil }
+
+func (alwaysFailingPeerJoiner) Notify(ctx context.Context, addr string, req *NotifyRequest) error {
+	return nil
+}
+
+func TestJoinerDoDoesNotBackOffAfterTheLastAllowedSweep(t *testing.T) {
+	j := NewJoiner(alwaysFailingPeerJoiner{}, "node1", "127.0.0.1:8300", true)
+	j.WithRetryConfig(JoinRetryConfig{
+		InitialBackoff: 50 * time.Millisecond,
+		MaxBackoff:     50 * time.Millisecond,
+		Multiplier:     1,
+		MaxAttempts:    2,
+	})
+
+	start := time.Now()
+	_, err := j.Do(context.Background(), logrus.New(), map[string]string{"node2": "127.0.0.2:8300"})
+	elapsed := time.Since(start)
+
+	require.Error(t, err)
+	// Two sweeps means exactly one backoff between them; a third (nonexistent) sweep must not be
+	// waited for.
+	assert.Less(t, elapsed, 100*time.Millisecond,
+		"Do must not wait after the final sweep, only between sweeps")
+}
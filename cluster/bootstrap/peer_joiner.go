@@ -0,0 +1,43 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package bootstrap
+
+import (
+	"context"
+
+	cmd "github.com/weaviate/weaviate/cluster/proto/api"
+)
+
+// PeerJoiner abstracts dialing a remote node's RPC service so that Joiner and Waiter don't
+// need to know how the underlying gRPC connection is established.
+type PeerJoiner interface {
+	// Join sends req to the node listening at addr and returns its response.
+	Join(ctx context.Context, addr string, req *cmd.JoinPeerRequest) (*cmd.JoinPeerResponse, error)
+	// Ping is a lightweight liveness probe used to decide whether a discovered peer is
+	// actually reachable before it is counted towards BootstrapExpect.
+	Ping(ctx context.Context, addr string) error
+	// Notify tells the node at addr that it should bootstrap together with req.NodeIDs.
+	// The receiving node must reject the request if it has already formed an opinion about a
+	// different set of voters, otherwise two disjoint Notify rounds could each bootstrap their
+	// own Raft cluster.
+	Notify(ctx context.Context, addr string, req *NotifyRequest) error
+}
+
+// NotifyRequest is sent simultaneously to every node in a BootstrapExpect-gated bootstrap once
+// at least BootstrapExpect reachable voters have been discovered. NodeIDs is always sorted so that every
+// node can compare it verbatim against its own discovered set.
+type NotifyRequest struct {
+	// LocalNodeID is the ID of the node sending the request.
+	LocalNodeID string
+	// NodeIDs is the sorted list of node IDs that independently agreed to bootstrap together.
+	NodeIDs []string
+}
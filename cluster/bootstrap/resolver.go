@@ -0,0 +1,43 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package bootstrap
+
+import "fmt"
+
+// ClusterStateReader is implemented by whatever component keeps track of node membership
+// (e.g. the memberlist-backed cluster state) and lets the bootstrap package resolve a node
+// name to a dialable address without depending on that component directly.
+type ClusterStateReader interface {
+	// NodeAddress returns the host address (no port) known for id, or "" if unknown.
+	NodeAddress(id string) string
+	// NodeHostname returns the host address known for nodeName and whether it is known at all.
+	NodeHostname(nodeName string) (string, bool)
+	// LocalName returns the name of the local node.
+	LocalName() string
+	// AllClusterMembers returns every known node name mapped to "host:raftPort".
+	AllClusterMembers(raftPort int) map[string]string
+}
+
+// ResolveRemoteNodes turns serverPortMap (node name -> raft port) into a map of node name ->
+// dialable "host:port" address using reader. Nodes reader cannot currently resolve to a host
+// are omitted so that callers never attempt to dial an empty address.
+func ResolveRemoteNodes(reader ClusterStateReader, serverPortMap map[string]int) map[string]string {
+	remoteNodes := make(map[string]string, len(serverPortMap))
+	for name, port := range serverPortMap {
+		host, ok := reader.NodeHostname(name)
+		if !ok || host == "" {
+			continue
+		}
+		remoteNodes[name] = fmt.Sprintf("%s:%d", host, port)
+	}
+	return remoteNodes
+}
@@ -0,0 +1,92 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package bootstrap
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// JoinRetryConfig controls how Joiner.Do sweeps over remoteNodes when none of them can be
+// joined. Rather than giving up after a single pass (fragile during a rolling restart, where
+// every peer can be briefly unreachable at once), Do loops over remoteNodes MaxAttempts times,
+// waiting a growing, jittered backoff between sweeps.
+type JoinRetryConfig struct {
+	// InitialBackoff is the wait before the second sweep.
+	InitialBackoff time.Duration
+	// MaxBackoff caps how large the backoff between sweeps can grow.
+	MaxBackoff time.Duration
+	// Multiplier grows the backoff after each sweep, e.g. 2.0 doubles it.
+	Multiplier float64
+	// Jitter is the fraction (0-1) of randomness added/subtracted from each backoff so that
+	// multiple nodes restarting together don't all retry in lockstep.
+	Jitter float64
+	// MaxAttempts is the number of sweeps over remoteNodes before giving up. 0 means retry
+	// forever until ctx is done.
+	MaxAttempts int
+}
+
+// DefaultJoinRetryConfig is the policy NewJoiner uses unless WithRetryConfig overrides it: up
+// to 10 sweeps, with a backoff starting at 50ms and doubling after each sweep up to 5s, +/-20%
+// jitter.
+func DefaultJoinRetryConfig() JoinRetryConfig {
+	return JoinRetryConfig{
+		InitialBackoff: 50 * time.Millisecond,
+		MaxBackoff:     5 * time.Second,
+		Multiplier:     2,
+		Jitter:         0.2,
+		MaxAttempts:    10,
+	}
+}
+
+// backoff returns the (jittered) wait before sweep attempt (1-indexed).
+func (c JoinRetryConfig) backoff(attempt int) time.Duration {
+	initial := c.InitialBackoff
+	if initial <= 0 {
+		initial = 50 * time.Millisecond
+	}
+	max := c.MaxBackoff
+	if max <= 0 {
+		max = initial
+	}
+	multiplier := c.Multiplier
+	if multiplier <= 1 {
+		multiplier = 1
+	}
+
+	wait := float64(initial)
+	for i := 1; i < attempt; i++ {
+		wait *= multiplier
+	}
+	if wait > float64(max) {
+		wait = float64(max)
+	}
+
+	if c.Jitter > 0 {
+		delta := wait * c.Jitter
+		wait += (rand.Float64()*2 - 1) * delta
+		if wait < 0 {
+			wait = 0
+		}
+	}
+	return time.Duration(wait)
+}
+
+// joinAttemptsTotal counts join attempts per node and result, so operators can diagnose join
+// failures (e.g. "every attempt against node3 times out") without grepping logs.
+var joinAttemptsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "weaviate_cluster_join_attempts_total",
+	Help: "Total number of raft cluster join attempts, labelled by target node and result.",
+}, []string{"node", "result"})
@@ -0,0 +1,44 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package bootstrap
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJoinRetryConfigBackoffGrowsAndCaps(t *testing.T) {
+	c := JoinRetryConfig{
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     1 * time.Second,
+		Multiplier:     2,
+		Jitter:         0, // deterministic for this test
+	}
+
+	assert.Equal(t, 100*time.Millisecond, c.backoff(1))
+	assert.Equal(t, 200*time.Millisecond, c.backoff(2))
+	assert.Equal(t, 400*time.Millisecond, c.backoff(3))
+	// attempt 5 would be 1.6s uncapped, so it must be clamped to MaxBackoff
+	assert.Equal(t, 1*time.Second, c.backoff(5))
+}
+
+func TestJoinRetryConfigJitterStaysWithinBounds(t *testing.T) {
+	c := JoinRetryConfig{InitialBackoff: time.Second, MaxBackoff: time.Second, Multiplier: 1, Jitter: 0.5}
+
+	for i := 0; i < 50; i++ {
+		wait := c.backoff(1)
+		assert.GreaterOrEqual(t, wait, 500*time.Millisecond)
+		assert.LessOrEqual(t, wait, 1500*time.Millisecond)
+	}
+}
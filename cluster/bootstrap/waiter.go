@@ -0,0 +1,230 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package bootstrap
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// defaultResolveInterval is how often Waiter re-resolves remoteNodes and re-probes them while
+// it is waiting for BootstrapExpect to be satisfied.
+const defaultResolveInterval = 2 * time.Second
+
+// Waiter defers cluster bootstrap until at least BootstrapExpect voters have been discovered
+// and confirmed reachable, mirroring Consul/Serf's "bootstrap-expect" behavior. It is meant to
+// run before Joiner.Do: once Wait returns, the caller has a reachable set it can safely
+// bootstrap or join from.
+type Waiter struct {
+	localNodeID   string
+	stateReader   ClusterStateReader
+	peerJoiner    PeerJoiner
+	serverPortMap map[string]int
+
+	// BootstrapExpect is the number of voters (including the local node) that must be
+	// reachable before bootstrap proceeds. A value of 1 preserves today's self-bootstrap
+	// behavior.
+	BootstrapExpect int
+	// BootstrapExpectTimeout bounds how long Wait will keep retrying before giving up.
+	BootstrapExpectTimeout time.Duration
+
+	// resolveInterval is overridable by tests; it defaults to defaultResolveInterval.
+	resolveInterval time.Duration
+
+	// notifyMu guards agreedNodeIDs.
+	notifyMu      sync.Mutex
+	agreedNodeIDs []string
+}
+
+// NewWaiter returns a *Waiter that will gate bootstrap on bootstrapExpect reachable voters.
+func NewWaiter(stateReader ClusterStateReader, peerJoiner PeerJoiner, localNodeID string,
+	serverPortMap map[string]int, bootstrapExpect int, bootstrapExpectTimeout time.Duration,
+) *Waiter {
+	return &Waiter{
+		localNodeID:            localNodeID,
+		stateReader:            stateReader,
+		peerJoiner:             peerJoiner,
+		serverPortMap:          serverPortMap,
+		BootstrapExpect:        bootstrapExpect,
+		BootstrapExpectTimeout: bootstrapExpectTimeout,
+		resolveInterval:        defaultResolveInterval,
+	}
+}
+
+// Wait blocks until BootstrapExpect voters (the local node plus any remote nodes that answer
+// Ping) have been discovered, then returns that reachable set keyed by node ID. If
+// BootstrapExpect is 1 it returns immediately with just the local node, preserving today's
+// single-node self-bootstrap path. If BootstrapExpectTimeout elapses first, it returns an error.
+func (w *Waiter) Wait(ctx context.Context, lg *logrus.Logger) (map[string]string, error) {
+	if w.BootstrapExpect <= 1 {
+		remoteNodes := ResolveRemoteNodes(w.stateReader, w.serverPortMap)
+		return map[string]string{w.localNodeID: remoteNodes[w.localNodeID]}, nil
+	}
+
+	interval := w.resolveInterval
+	if interval <= 0 {
+		interval = defaultResolveInterval
+	}
+
+	var deadline <-chan time.Time
+	if w.BootstrapExpectTimeout > 0 {
+		timer := time.NewTimer(w.BootstrapExpectTimeout)
+		defer timer.Stop()
+		deadline = timer.C
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		reachable := w.probeOnce(ctx, lg, interval)
+		if len(reachable) >= w.BootstrapExpect {
+			return w.notifyAll(ctx, lg, reachable)
+		}
+
+		lg.WithFields(logrus.Fields{
+			"reachable":        len(reachable),
+			"bootstrap_expect": w.BootstrapExpect,
+		}).Info("waiting for more peers before bootstrapping cluster")
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-deadline:
+			return nil, fmt.Errorf("bootstrap-expect: only found %d/%d reachable voters after %s",
+				len(reachable), w.BootstrapExpect, w.BootstrapExpectTimeout)
+		case <-ticker.C:
+			// re-resolve and probe again
+		}
+	}
+}
+
+// probeOnce re-resolves remoteNodes from w.stateReader and pings each one, returning the set
+// of node IDs (including the local node) that answered.
+func (w *Waiter) probeOnce(ctx context.Context, lg *logrus.Logger, interval time.Duration) map[string]string {
+	remoteNodes := ResolveRemoteNodes(w.stateReader, w.serverPortMap)
+	reachable := map[string]string{}
+
+	for name, addr := range remoteNodes {
+		if name == w.localNodeID {
+			reachable[name] = addr
+			continue
+		}
+
+		pingCtx, cancel := context.WithTimeout(ctx, interval/2)
+		err := w.peerJoiner.Ping(pingCtx, addr)
+		cancel()
+		if err != nil {
+			lg.WithField("node", name).WithField("address", addr).WithError(err).Debug("peer not yet reachable")
+			continue
+		}
+		reachable[name] = addr
+	}
+
+	return reachable
+}
+
+// notifyAll sends a NotifyRequest carrying the sorted set of reachable node IDs to every
+// reachable peer simultaneously, so exactly one Raft cluster is formed: every node that
+// independently reached BootstrapExpect calls notifyAll with (ideally) the same reachable set,
+// and HandleNotify rejects any request that disagrees with a set this node has already agreed
+// to, preventing two disjoint subsets of nodes from each bootstrapping their own cluster.
+func (w *Waiter) notifyAll(ctx context.Context, lg *logrus.Logger, reachable map[string]string) (map[string]string, error) {
+	req := &NotifyRequest{LocalNodeID: w.localNodeID, NodeIDs: SortedNodeIDs(reachable)}
+
+	// Apply the same agreement check locally that a receiving peer applies, so this node also
+	// refuses to proceed if it already committed to a different voter set (e.g. a previous sweep
+	// that later turned out to be stale).
+	if err := w.HandleNotify(req); err != nil {
+		return nil, err
+	}
+
+	var wg sync.WaitGroup
+	errCh := make(chan error, len(reachable))
+	for name, addr := range reachable {
+		if name == w.localNodeID {
+			continue
+		}
+		wg.Add(1)
+		go func(name, addr string) {
+			defer wg.Done()
+			if err := w.peerJoiner.Notify(ctx, addr, req); err != nil {
+				errCh <- fmt.Errorf("notify %s(%s): %w", name, addr, err)
+			}
+		}(name, addr)
+	}
+	wg.Wait()
+	close(errCh)
+
+	var errs []string
+	for err := range errCh {
+		lg.WithError(err).Warn("notify failed for a voter")
+		errs = append(errs, err.Error())
+	}
+	if len(errs) > 0 {
+		sort.Strings(errs)
+		return nil, fmt.Errorf("bootstrap-expect: notify failed for some voters: %s", strings.Join(errs, "; "))
+	}
+
+	return reachable, nil
+}
+
+// HandleNotify is the receiving side of notifyAll: the first NotifyRequest this Waiter sees is
+// accepted and remembered, and every subsequent one must carry exactly the same NodeIDs. A node
+// that comes up, discovers a different set of peers (e.g. because of a network partition) and
+// tries to Notify again is rejected rather than allowed to bootstrap a second, disjoint cluster.
+func (w *Waiter) HandleNotify(req *NotifyRequest) error {
+	w.notifyMu.Lock()
+	defer w.notifyMu.Unlock()
+
+	if w.agreedNodeIDs == nil {
+		w.agreedNodeIDs = req.NodeIDs
+		return nil
+	}
+	if !req.AgreesWith(w.agreedNodeIDs) {
+		return fmt.Errorf("bootstrap-expect: notify from %s with voters %v disagrees with already-agreed voters %v",
+			req.LocalNodeID, req.NodeIDs, w.agreedNodeIDs)
+	}
+	return nil
+}
+
+// SortedNodeIDs returns the sorted node IDs of reachable, suitable for embedding in a
+// NotifyRequest so every node can agree on exactly the same discovered set.
+func SortedNodeIDs(reachable map[string]string) []string {
+	ids := make([]string, 0, len(reachable))
+	for id := range reachable {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// AgreesWith reports whether NodeIDs matches other exactly (same members, same order). The
+// receiving side of a Notify call uses this to reject a request that disagrees with a set it
+// has already accepted, preventing a split-brain bootstrap.
+func (r *NotifyRequest) AgreesWith(other []string) bool {
+	if len(r.NodeIDs) != len(other) {
+		return false
+	}
+	for i, id := range r.NodeIDs {
+		if id != other[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,152 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ V /| | (_| | ||  __/
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package bootstrap
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cmd "github.com/weaviate/weaviate/cluster/proto/api"
+)
+
+type fakeStateReader struct {
+	hosts map[string]string
+}
+
+func (f *fakeStateReader) NodeAddress(id string) string { return f.hosts[id] }
+
+func (f *fakeStateReader) NodeHostname(nodeName string) (string, bool) {
+	host, ok := f.hosts[nodeName]
+	return host, ok
+}
+
+func (f *fakeStateReader) LocalName() string { return "" }
+
+func (f *fakeStateReader) AllClusterMembers(raftPort int) map[string]string {
+	return nil
+}
+
+// fakePeerJoiner lets tests decide which addresses respond to Ping, and records every Notify
+// call it receives so tests can assert on the fan-out.
+type fakePeerJoiner struct {
+	mu         sync.Mutex
+	reachable  map[string]bool
+	notifyErr  error
+	notifyCall []notifyCall
+}
+
+type notifyCall struct {
+	addr string
+	req  *NotifyRequest
+}
+
+func (f *fakePeerJoiner) Join(ctx context.Context, addr string, req *cmd.JoinPeerRequest) (*cmd.JoinPeerResponse, error) {
+	return nil, nil
+}
+
+func (f *fakePeerJoiner) Ping(ctx context.Context, addr string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.reachable[addr] {
+		return nil
+	}
+	return assert.AnError
+}
+
+func (f *fakePeerJoiner) Notify(ctx context.Context, addr string, req *NotifyRequest) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.notifyCall = append(f.notifyCall, notifyCall{addr: addr, req: req})
+	return f.notifyErr
+}
+
+func TestWaiterSingleNodeReturnsImmediately(t *testing.T) {
+	reader := &fakeStateReader{hosts: map[string]string{"node1": "127.0.0.1"}}
+	joiner := &fakePeerJoiner{}
+	w := NewWaiter(reader, joiner, "node1", map[string]int{"node1": 8300}, 1, time.Second)
+
+	reachable, err := w.Wait(context.Background(), logrus.New())
+	require.NoError(t, err)
+	assert.Equal(t, "127.0.0.1:8300", reachable["node1"])
+}
+
+func TestWaiterWaitsUntilBootstrapExpectReachable(t *testing.T) {
+	reader := &fakeStateReader{hosts: map[string]string{
+		"node1": "127.0.0.1",
+		"node2": "127.0.0.2",
+		"node3": "127.0.0.3",
+	}}
+	joiner := &fakePeerJoiner{reachable: map[string]bool{}}
+	w := NewWaiter(reader, joiner, "node1",
+		map[string]int{"node1": 8300, "node2": 8300, "node3": 8300}, 3, 2*time.Second)
+	w.resolveInterval = 20 * time.Millisecond
+
+	go func() {
+		time.Sleep(30 * time.Millisecond)
+		joiner.mu.Lock()
+		joiner.reachable["127.0.0.2:8300"] = true
+		joiner.reachable["127.0.0.3:8300"] = true
+		joiner.mu.Unlock()
+	}()
+
+	reachable, err := w.Wait(context.Background(), logrus.New())
+	require.NoError(t, err)
+	assert.Len(t, reachable, 3)
+
+	joiner.mu.Lock()
+	defer joiner.mu.Unlock()
+	require.Len(t, joiner.notifyCall, 2, "every reachable peer besides the local node must be notified")
+	for _, call := range joiner.notifyCall {
+		assert.Equal(t, []string{"node1", "node2", "node3"}, call.req.NodeIDs)
+	}
+}
+
+func TestWaiterTimesOutWhenNotEnoughPeersAppear(t *testing.T) {
+	reader := &fakeStateReader{hosts: map[string]string{"node1": "127.0.0.1", "node2": "127.0.0.2"}}
+	joiner := &fakePeerJoiner{reachable: map[string]bool{}}
+	w := NewWaiter(reader, joiner, "node1", map[string]int{"node1": 8300, "node2": 8300}, 2, 50*time.Millisecond)
+	w.resolveInterval = 10 * time.Millisecond
+
+	_, err := w.Wait(context.Background(), logrus.New())
+	require.Error(t, err)
+}
+
+func TestWaiterReturnsErrorWhenAPeerRejectsNotify(t *testing.T) {
+	reader := &fakeStateReader{hosts: map[string]string{"node1": "127.0.0.1", "node2": "127.0.0.2"}}
+	joiner := &fakePeerJoiner{
+		reachable: map[string]bool{"127.0.0.2:8300": true},
+		notifyErr: assert.AnError,
+	}
+	w := NewWaiter(reader, joiner, "node1", map[string]int{"node1": 8300, "node2": 8300}, 2, time.Second)
+
+	_, err := w.Wait(context.Background(), logrus.New())
+	require.Error(t, err)
+}
+
+func TestWaiterHandleNotifyRejectsDisagreeingVoterSet(t *testing.T) {
+	w := NewWaiter(&fakeStateReader{}, &fakePeerJoiner{}, "node1", nil, 3, time.Second)
+
+	require.NoError(t, w.HandleNotify(&NotifyRequest{LocalNodeID: "node1", NodeIDs: []string{"node1", "node2", "node3"}}))
+
+	// Same set again (e.g. a retried Notify) must still be accepted.
+	require.NoError(t, w.HandleNotify(&NotifyRequest{LocalNodeID: "node2", NodeIDs: []string{"node1", "node2", "node3"}}))
+
+	// A disjoint set (e.g. from a node that discovered a different partition) must be rejected.
+	err := w.HandleNotify(&NotifyRequest{LocalNodeID: "node4", NodeIDs: []string{"node1", "node4", "node5"}})
+	require.Error(t, err)
+}
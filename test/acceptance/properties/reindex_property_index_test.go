@@ -0,0 +1,133 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package properties
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/require"
+
+	clobjects "github.com/weaviate/weaviate/client/objects"
+	clschema "github.com/weaviate/weaviate/client/schema"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/test/docker"
+	"github.com/weaviate/weaviate/test/helper"
+	graphqlhelper "github.com/weaviate/weaviate/test/helper/graphql"
+)
+
+// testReindexPropertyIndex verifies that a searchable index deleted via
+// SchemaObjectsPropertiesDelete can be restored with SchemaObjectsPropertiesReindex without
+// recreating the class or reingesting objects.
+func testReindexPropertyIndex(compose *docker.DockerCompose) func(t *testing.T) {
+	return func(t *testing.T) {
+		bookClass := "BookReindex"
+		author := "author"
+
+		ptrBool := func(in bool) *bool { return &in }
+
+		deleteClassParams := clschema.NewSchemaObjectsDeleteParams().WithClassName(bookClass)
+		deleteClassResp, err := helper.Client(t).Schema.SchemaObjectsDelete(deleteClassParams, nil)
+		helper.AssertRequestOk(t, deleteClassResp, err, nil)
+
+		book := &models.Class{
+			Class: bookClass,
+			Properties: []*models.Property{
+				{
+					Name:            author,
+					DataType:        []string{schema.DataTypeText.String()},
+					IndexFilterable: ptrBool(true),
+					IndexSearchable: ptrBool(true),
+				},
+			},
+		}
+		createParams := clschema.NewSchemaObjectsCreateParams().WithObjectClass(book)
+		createResp, err := helper.Client(t).Schema.SchemaObjectsCreate(createParams, nil)
+		helper.AssertRequestOk(t, createResp, err, nil)
+
+		objCreateParams := clobjects.NewObjectsCreateParams().WithBody(&models.Object{
+			ID:         strfmt.UUID("00000000-0000-0000-0000-000000000010"),
+			Class:      bookClass,
+			Properties: map[string]any{"author": "Frank Herbert"},
+		})
+		objResp, err := helper.Client(t).Objects.ObjectsCreate(objCreateParams, nil)
+		helper.AssertRequestOk(t, objResp, err, nil)
+
+		// authorSearchResultCount makes no require/assert calls so it's safe to call from the
+		// goroutine testify's Eventually polls on, not just the test's own goroutine.
+		authorSearchResultCount := func(t *testing.T) (int, error) {
+			query := `
+				{
+					Get{
+						BookReindex(
+							bm25:{
+								query:"herbert"
+								properties:"author"
+							}
+						){
+							author
+						}
+					}
+				}
+			`
+			resp, err := graphqlhelper.QueryGraphQL(t, helper.RootAuth, "", query, nil)
+			if err != nil {
+				return 0, err
+			}
+			if resp == nil {
+				return 0, fmt.Errorf("nil GraphQL response")
+			}
+			cls, ok := resp.Data["Get"].(map[string]any)["BookReindex"].([]any)
+			if !ok {
+				return 0, fmt.Errorf("unexpected GraphQL response shape: %+v", resp.Data)
+			}
+			return len(cls), nil
+		}
+
+		searchByAuthor := func(t *testing.T, resultsShouldExist bool) {
+			count, err := authorSearchResultCount(t)
+			require.NoError(t, err)
+			if resultsShouldExist {
+				require.Equal(t, 1, count)
+			} else {
+				require.Equal(t, 0, count)
+			}
+		}
+
+		t.Run("delete author searchable index", func(t *testing.T) {
+			deleteParams := clschema.NewSchemaObjectsPropertiesDeleteParams().
+				WithClassName(bookClass).WithPropertyName(author).
+				WithBody(&models.DeletePropertyIndexRequest{IndexSearchable: ptrBool(true)})
+			deleteOk, err := helper.Client(t).Schema.SchemaObjectsPropertiesDelete(deleteParams, nil)
+			helper.AssertRequestOk(t, deleteOk, err, nil)
+
+			searchByAuthor(t, false)
+		})
+
+		t.Run("reindex author searchable index restores search results", func(t *testing.T) {
+			reindexParams := clschema.NewSchemaObjectsPropertiesReindexParams().
+				WithClassName(bookClass).WithPropertyName(author).
+				WithBody(&models.ReindexPropertyIndexRequest{IndexSearchable: ptrBool(true)})
+			reindexAccepted, err := helper.Client(t).Schema.SchemaObjectsPropertiesReindex(reindexParams, nil)
+			helper.AssertRequestOk(t, reindexAccepted, err, nil)
+			require.NotEmpty(t, reindexAccepted.Payload.JobID)
+
+			require.Eventually(t, func() bool {
+				count, err := authorSearchResultCount(t)
+				return err == nil && count == 1
+			}, 10*time.Second, 200*time.Millisecond)
+		})
+	}
+}
@@ -0,0 +1,153 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+)
+
+// ScannedObject is one object yielded while scanning a class for ObjectScanner.ScanClass.
+type ScannedObject struct {
+	ID    string
+	Value interface{}
+}
+
+// ObjectScanner reads every object currently stored for a class, without taking a shard lock:
+// RebuildPropertyIndex only needs a consistent-enough pass to populate a bucket it will swap in
+// later, not a frozen snapshot.
+type ObjectScanner interface {
+	// CountClass returns how many objects currently exist for class, used to report progress
+	// totals as ScanClass runs.
+	CountClass(ctx context.Context, class string) (int, error)
+	// ScanClass calls fn once per object currently stored for class. A non-nil error from fn
+	// aborts the scan and is returned from ScanClass.
+	ScanClass(ctx context.Context, class string, fn func(obj ScannedObject) error) error
+}
+
+// IndexBucketBuilder accumulates values for a single inverted-index bucket (searchable,
+// filterable or range-filterable) being rebuilt, then swaps the finished bucket in for the shard.
+type IndexBucketBuilder interface {
+	// Add indexes value under objID in the bucket under construction.
+	Add(ctx context.Context, objID string, value interface{}) error
+	// SwapIn replaces the shard's live bucket with the one built by Add, atomically from the
+	// perspective of readers. The caller holds the shard lock for the duration of SwapIn.
+	SwapIn(ctx context.Context) error
+}
+
+// ShardLocker gates the brief, exclusive window RebuildPropertyIndex needs to swap a rebuilt
+// bucket in for the shard holding class. It is implemented by the storage layer on top of
+// whatever per-shard locking it already uses for compaction and other maintenance operations.
+type ShardLocker interface {
+	// LockShard blocks until the shard holding class is locked, then returns a func that
+	// releases it. Callers must call the returned func exactly once.
+	LockShard(ctx context.Context, class string) (unlock func(), err error)
+}
+
+// BucketBuilderFactory creates the IndexBucketBuilder for whichever index kind is being rebuilt.
+type BucketBuilderFactory interface {
+	NewSearchableBucketBuilder(class, property string) (IndexBucketBuilder, error)
+	NewFilterableBucketBuilder(class, property string) (IndexBucketBuilder, error)
+	NewRangeFilterableBucketBuilder(class, property string) (IndexBucketBuilder, error)
+}
+
+// defaultPropertyIndexRebuilder is the storage-backed PropertyIndexRebuilder used in production.
+// It scans the class once, feeding every object into whichever bucket builders the caller asked
+// for, and only takes a shard lock to swap the finished buckets in - the scan itself runs against
+// live, unlocked shard state.
+type defaultPropertyIndexRebuilder struct {
+	scanner ObjectScanner
+	locker  ShardLocker
+	buckets BucketBuilderFactory
+}
+
+// NewPropertyIndexRebuilder returns a PropertyIndexRebuilder that rebuilds buckets by scanning
+// objects via scanner and swapping the rebuilt buckets in under a lock taken through locker.
+func NewPropertyIndexRebuilder(scanner ObjectScanner, locker ShardLocker, buckets BucketBuilderFactory) PropertyIndexRebuilder {
+	return &defaultPropertyIndexRebuilder{scanner: scanner, locker: locker, buckets: buckets}
+}
+
+// RebuildPropertyIndex implements PropertyIndexRebuilder.
+func (d *defaultPropertyIndexRebuilder) RebuildPropertyIndex(ctx context.Context, class, property string,
+	indexSearchable, indexFilterable, indexRangeFilters bool,
+	progress func(objectsDone, objectsTotal int),
+) error {
+	builders, err := d.newRequestedBuilders(class, property, indexSearchable, indexFilterable, indexRangeFilters)
+	if err != nil {
+		return err
+	}
+
+	total, err := d.scanner.CountClass(ctx, class)
+	if err != nil {
+		return fmt.Errorf("count objects for class %s: %w", class, err)
+	}
+
+	done := 0
+	err = d.scanner.ScanClass(ctx, class, func(obj ScannedObject) error {
+		for _, b := range builders {
+			if err := b.Add(ctx, obj.ID, obj.Value); err != nil {
+				return fmt.Errorf("add object %s to rebuilt index: %w", obj.ID, err)
+			}
+		}
+		done++
+		progress(done, total)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("scan class %s: %w", class, err)
+	}
+
+	unlock, err := d.locker.LockShard(ctx, class)
+	if err != nil {
+		return fmt.Errorf("lock shard for class %s: %w", class, err)
+	}
+	defer unlock()
+
+	for _, b := range builders {
+		if err := b.SwapIn(ctx); err != nil {
+			return fmt.Errorf("swap in rebuilt index for class %s property %s: %w", class, property, err)
+		}
+	}
+
+	return nil
+}
+
+// newRequestedBuilders creates one IndexBucketBuilder per index kind the caller asked for.
+func (d *defaultPropertyIndexRebuilder) newRequestedBuilders(class, property string,
+	indexSearchable, indexFilterable, indexRangeFilters bool,
+) ([]IndexBucketBuilder, error) {
+	var builders []IndexBucketBuilder
+
+	if indexSearchable {
+		b, err := d.buckets.NewSearchableBucketBuilder(class, property)
+		if err != nil {
+			return nil, fmt.Errorf("new searchable bucket builder for %s.%s: %w", class, property, err)
+		}
+		builders = append(builders, b)
+	}
+	if indexFilterable {
+		b, err := d.buckets.NewFilterableBucketBuilder(class, property)
+		if err != nil {
+			return nil, fmt.Errorf("new filterable bucket builder for %s.%s: %w", class, property, err)
+		}
+		builders = append(builders, b)
+	}
+	if indexRangeFilters {
+		b, err := d.buckets.NewRangeFilterableBucketBuilder(class, property)
+		if err != nil {
+			return nil, fmt.Errorf("new range-filterable bucket builder for %s.%s: %w", class, property, err)
+		}
+		builders = append(builders, b)
+	}
+
+	return builders, nil
+}
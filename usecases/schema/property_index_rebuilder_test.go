@@ -0,0 +1,184 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeScanner struct {
+	objects  []ScannedObject
+	countErr error
+	scanErr  error
+}
+
+func (f *fakeScanner) CountClass(ctx context.Context, class string) (int, error) {
+	if f.countErr != nil {
+		return 0, f.countErr
+	}
+	return len(f.objects), nil
+}
+
+func (f *fakeScanner) ScanClass(ctx context.Context, class string, fn func(obj ScannedObject) error) error {
+	if f.scanErr != nil {
+		return f.scanErr
+	}
+	for _, obj := range f.objects {
+		if err := fn(obj); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type fakeBucketBuilder struct {
+	mu        sync.Mutex
+	added     map[string]interface{}
+	swappedIn bool
+
+	// addLocked and swapLocked are filled in by lockTrackingBuilder.
+	addLocked  []bool
+	swapLocked []bool
+}
+
+func newFakeBucketBuilder() *fakeBucketBuilder {
+	return &fakeBucketBuilder{added: map[string]interface{}{}}
+}
+
+func (f *fakeBucketBuilder) Add(ctx context.Context, objID string, value interface{}) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.added[objID] = value
+	return nil
+}
+
+func (f *fakeBucketBuilder) SwapIn(ctx context.Context) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.swappedIn = true
+	return nil
+}
+
+type fakeShardLocker struct {
+	mu     sync.Mutex
+	locked bool
+}
+
+func (f *fakeShardLocker) LockShard(ctx context.Context, class string) (func(), error) {
+	f.mu.Lock()
+	f.locked = true
+	f.mu.Unlock()
+	return func() {
+		f.mu.Lock()
+		f.locked = false
+		f.mu.Unlock()
+	}, nil
+}
+
+type fakeBucketBuilderFactory struct {
+	searchable, filterable, rangeFilterable *fakeBucketBuilder
+	locker                                  *fakeShardLocker
+}
+
+func (f *fakeBucketBuilderFactory) NewSearchableBucketBuilder(class, property string) (IndexBucketBuilder, error) {
+	f.searchable = newFakeBucketBuilder()
+	return &lockTrackingBuilder{fakeBucketBuilder: f.searchable, locker: f.locker}, nil
+}
+
+func (f *fakeBucketBuilderFactory) NewFilterableBucketBuilder(class, property string) (IndexBucketBuilder, error) {
+	f.filterable = newFakeBucketBuilder()
+	return &lockTrackingBuilder{fakeBucketBuilder: f.filterable, locker: f.locker}, nil
+}
+
+func (f *fakeBucketBuilderFactory) NewRangeFilterableBucketBuilder(class, property string) (IndexBucketBuilder, error) {
+	f.rangeFilterable = newFakeBucketBuilder()
+	return &lockTrackingBuilder{fakeBucketBuilder: f.rangeFilterable, locker: f.locker}, nil
+}
+
+// lockTrackingBuilder records, at the moment each method runs, whether the shard lock was held -
+// used to assert Add runs unlocked and SwapIn runs locked.
+type lockTrackingBuilder struct {
+	*fakeBucketBuilder
+	locker *fakeShardLocker
+}
+
+func (b *lockTrackingBuilder) Add(ctx context.Context, objID string, value interface{}) error {
+	b.locker.mu.Lock()
+	b.addLocked = append(b.addLocked, b.locker.locked)
+	b.locker.mu.Unlock()
+	return b.fakeBucketBuilder.Add(ctx, objID, value)
+}
+
+func (b *lockTrackingBuilder) SwapIn(ctx context.Context) error {
+	b.locker.mu.Lock()
+	b.swapLocked = append(b.swapLocked, b.locker.locked)
+	b.locker.mu.Unlock()
+	return b.fakeBucketBuilder.SwapIn(ctx)
+}
+
+func TestRebuildPropertyIndexScansUnlockedAndSwapsInUnderLock(t *testing.T) {
+	scanner := &fakeScanner{objects: []ScannedObject{
+		{ID: "obj1", Value: "Frank Herbert"},
+		{ID: "obj2", Value: "Jaroslaw Grzedowicz"},
+	}}
+	locker := &fakeShardLocker{}
+	factory := &fakeBucketBuilderFactory{locker: locker}
+	rebuilder := NewPropertyIndexRebuilder(scanner, locker, factory)
+
+	var progressCalls [][2]int
+	err := rebuilder.RebuildPropertyIndex(context.Background(), "Book", "author", true, false, false,
+		func(done, total int) { progressCalls = append(progressCalls, [2]int{done, total}) })
+	require.NoError(t, err)
+
+	require.NotNil(t, factory.searchable)
+	assert.Nil(t, factory.filterable, "only the requested bucket kind should be built")
+	assert.Nil(t, factory.rangeFilterable)
+
+	assert.Equal(t, map[string]interface{}{"obj1": "Frank Herbert", "obj2": "Jaroslaw Grzedowicz"}, factory.searchable.added)
+	assert.True(t, factory.searchable.swappedIn)
+	assert.Equal(t, []bool{false, false}, factory.searchable.addLocked, "objects must be added without the shard lock held")
+	assert.Equal(t, []bool{true}, factory.searchable.swapLocked, "the bucket must be swapped in with the shard lock held")
+	assert.Equal(t, [][2]int{{1, 2}, {2, 2}}, progressCalls)
+	assert.False(t, locker.locked, "the shard lock must be released once RebuildPropertyIndex returns")
+}
+
+func TestRebuildPropertyIndexCanBuildMultipleBucketKindsAtOnce(t *testing.T) {
+	scanner := &fakeScanner{objects: []ScannedObject{{ID: "obj1", Value: 1960}}}
+	locker := &fakeShardLocker{}
+	factory := &fakeBucketBuilderFactory{locker: locker}
+	rebuilder := NewPropertyIndexRebuilder(scanner, locker, factory)
+
+	err := rebuilder.RebuildPropertyIndex(context.Background(), "Book", "year", false, true, true, func(done, total int) {})
+	require.NoError(t, err)
+
+	require.NotNil(t, factory.filterable)
+	require.NotNil(t, factory.rangeFilterable)
+	assert.Nil(t, factory.searchable)
+	assert.True(t, factory.filterable.swappedIn)
+	assert.True(t, factory.rangeFilterable.swappedIn)
+}
+
+func TestRebuildPropertyIndexReturnsErrorFromScan(t *testing.T) {
+	scanner := &fakeScanner{scanErr: assert.AnError}
+	locker := &fakeShardLocker{}
+	factory := &fakeBucketBuilderFactory{locker: locker}
+	rebuilder := NewPropertyIndexRebuilder(scanner, locker, factory)
+
+	err := rebuilder.RebuildPropertyIndex(context.Background(), "Book", "author", true, false, false, func(done, total int) {})
+	require.Error(t, err)
+	assert.False(t, factory.searchable.swappedIn, "a failed scan must not swap a partial bucket in")
+}
@@ -0,0 +1,183 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ReindexStatus is the lifecycle state of a property reindex job, surfaced to callers through
+// ReindexPropertyIndexResponse and polled the same way other async schema operations report
+// progress.
+type ReindexStatus string
+
+const (
+	ReindexStatusQueued    ReindexStatus = "queued"
+	ReindexStatusRunning   ReindexStatus = "running"
+	ReindexStatusCompleted ReindexStatus = "completed"
+	ReindexStatusFailed    ReindexStatus = "failed"
+)
+
+// PropertyIndexRebuilder rebuilds the requested inverted-index buckets for a property by
+// streaming every object currently stored for the class through the tokenizer/analyzer
+// pipeline, then swapping the rebuilt buckets in. It is implemented by the storage layer;
+// PropertyReindexer only owns job bookkeeping (queueing, dedup, progress, resumability).
+type PropertyIndexRebuilder interface {
+	// RebuildPropertyIndex (re)populates the requested buckets for class/property. Scanning
+	// existing objects happens without holding any shard lock; RebuildPropertyIndex only takes a
+	// shard-level lock while swapping the newly built bucket in for the old (or absent) one, so
+	// reads and writes against the shard are not blocked for the duration of the scan. progress
+	// is called periodically with (objectsDone, objectsTotal) so the job's progress can be
+	// polled while it runs. Implementations must be safe to call again for the same
+	// class/property/flags after a crash or restart: the requested buckets are rebuilt again
+	// from a fresh scan (the default implementation does not skip a bucket that is already
+	// fully rebuilt), and reads never see a mix of old and new data.
+	RebuildPropertyIndex(ctx context.Context, class, property string,
+		indexSearchable, indexFilterable, indexRangeFilters bool,
+		progress func(objectsDone, objectsTotal int),
+	) error
+}
+
+// SchemaReader is the narrow slice of schema state PropertyReindexer needs: just enough to
+// reject a reindex request for a class or property that doesn't exist.
+type SchemaReader interface {
+	ClassExists(class string) bool
+	PropertyExists(class, property string) bool
+}
+
+// ReindexJob tracks one in-flight or completed property reindex.
+type ReindexJob struct {
+	ID       string
+	Class    string
+	Property string
+	Status   ReindexStatus
+	Err      string
+
+	// ObjectsDone and ObjectsTotal are updated as PropertyIndexRebuilder reports progress.
+	ObjectsDone  int
+	ObjectsTotal int
+}
+
+// PropertyReindexer implements SchemaObjectsPropertiesReindex, the async counterpart to
+// SchemaObjectsPropertiesDelete. Unlike delete, rebuilding an index means re-scanning every
+// object in the class, so the work happens in a background goroutine and is tracked as a
+// ReindexJob whose state and progress can be polled via Status.
+type PropertyReindexer struct {
+	schemaReader SchemaReader
+	rebuilder    PropertyIndexRebuilder
+
+	mu   sync.Mutex
+	jobs map[string]*ReindexJob
+}
+
+// NewPropertyReindexer returns a *PropertyReindexer that rebuilds indexes via rebuilder,
+// validating class/property names against schemaReader.
+func NewPropertyReindexer(schemaReader SchemaReader, rebuilder PropertyIndexRebuilder) *PropertyReindexer {
+	return &PropertyReindexer{schemaReader: schemaReader, rebuilder: rebuilder, jobs: map[string]*ReindexJob{}}
+}
+
+// jobKey identifies a reindex request so that retrying the exact same request (e.g. after a
+// crash, or a client simply calling the endpoint twice) returns the existing job instead of
+// starting a redundant rebuild - this is what makes the endpoint idempotent.
+func jobKey(class, property string, searchable, filterable, rangeFilters bool) string {
+	return fmt.Sprintf("%s/%s/%v/%v/%v", class, property, searchable, filterable, rangeFilters)
+}
+
+// isInFlight reports whether a job in status s is still doing work and should be returned as-is
+// to a caller making the same request again. A completed or failed job is done: the next request
+// for the same key starts a fresh job rather than replaying a stale result forever.
+func isInFlight(s ReindexStatus) bool {
+	return s == ReindexStatusQueued || s == ReindexStatusRunning
+}
+
+// SchemaObjectsPropertiesReindex starts (or returns the existing) asynchronous job that rebuilds
+// the inverted-index buckets requested in req for class/property. It is the counterpart to
+// SchemaObjectsPropertiesDelete: once an index has been deleted, this is the only way to get it
+// back without recreating the class and reingesting every object.
+func (r *PropertyReindexer) SchemaObjectsPropertiesReindex(ctx context.Context, class, property string,
+	req *models.ReindexPropertyIndexRequest,
+) (*models.ReindexPropertyIndexResponse, error) {
+	searchable := req.IndexSearchable != nil && *req.IndexSearchable
+	filterable := req.IndexFilterable != nil && *req.IndexFilterable
+	rangeFilters := req.IndexRangeFilters != nil && *req.IndexRangeFilters
+
+	if !searchable && !filterable && !rangeFilters {
+		return nil, fmt.Errorf("at least one of indexSearchable, indexFilterable, indexRangeFilters must be true")
+	}
+	if !r.schemaReader.ClassExists(class) {
+		return nil, fmt.Errorf("class %s: not found", class)
+	}
+	if !r.schemaReader.PropertyExists(class, property) {
+		return nil, fmt.Errorf("property name %s: not found", property)
+	}
+
+	key := jobKey(class, property, searchable, filterable, rangeFilters)
+
+	r.mu.Lock()
+	if existing, ok := r.jobs[key]; ok && isInFlight(existing.Status) {
+		r.mu.Unlock()
+		return jobResponse(existing), nil
+	}
+	job := &ReindexJob{ID: key, Class: class, Property: property, Status: ReindexStatusQueued}
+	r.jobs[key] = job
+	r.mu.Unlock()
+
+	go r.run(context.WithoutCancel(ctx), job, searchable, filterable, rangeFilters)
+
+	return jobResponse(job), nil
+}
+
+// Status returns the current state of the job started for class/property/flags, or nil if no
+// such job has ever been started.
+func (r *PropertyReindexer) Status(class, property string, searchable, filterable, rangeFilters bool) *ReindexJob {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	job, ok := r.jobs[jobKey(class, property, searchable, filterable, rangeFilters)]
+	if !ok {
+		return nil
+	}
+	cp := *job
+	return &cp
+}
+
+// run drives job to completion using r.rebuilder, updating job's status and progress as it goes
+// so concurrent status polls always see up-to-date information.
+func (r *PropertyReindexer) run(ctx context.Context, job *ReindexJob, searchable, filterable, rangeFilters bool) {
+	r.setStatus(job, ReindexStatusRunning, "")
+
+	err := r.rebuilder.RebuildPropertyIndex(ctx, job.Class, job.Property, searchable, filterable, rangeFilters,
+		func(done, total int) {
+			r.mu.Lock()
+			job.ObjectsDone, job.ObjectsTotal = done, total
+			r.mu.Unlock()
+		})
+	if err != nil {
+		r.setStatus(job, ReindexStatusFailed, err.Error())
+		return
+	}
+	r.setStatus(job, ReindexStatusCompleted, "")
+}
+
+func (r *PropertyReindexer) setStatus(job *ReindexJob, status ReindexStatus, errMsg string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	job.Status = status
+	job.Err = errMsg
+}
+
+func jobResponse(job *ReindexJob) *models.ReindexPropertyIndexResponse {
+	return &models.ReindexPropertyIndexResponse{JobID: job.ID, Status: string(job.Status)}
+}
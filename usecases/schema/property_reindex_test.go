@@ -0,0 +1,126 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2026 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+type fakeSchemaReader struct {
+	classes    map[string]bool
+	properties map[string]bool
+}
+
+func (f *fakeSchemaReader) ClassExists(class string) bool { return f.classes[class] }
+
+func (f *fakeSchemaReader) PropertyExists(class, property string) bool {
+	return f.properties[class+"."+property]
+}
+
+type fakeRebuilder struct {
+	mu       sync.Mutex
+	calls    int
+	blockErr error
+	done     chan struct{}
+}
+
+func (f *fakeRebuilder) RebuildPropertyIndex(ctx context.Context, class, property string,
+	searchable, filterable, rangeFilters bool, progress func(done, total int),
+) error {
+	f.mu.Lock()
+	f.calls++
+	f.mu.Unlock()
+
+	progress(1, 2)
+	if f.done != nil {
+		<-f.done
+	}
+	progress(2, 2)
+	return f.blockErr
+}
+
+func ptrBool(b bool) *bool { return &b }
+
+func TestSchemaObjectsPropertiesReindexRejectsUnknownClassOrProperty(t *testing.T) {
+	reader := &fakeSchemaReader{classes: map[string]bool{}, properties: map[string]bool{}}
+	r := NewPropertyReindexer(reader, &fakeRebuilder{})
+
+	_, err := r.SchemaObjectsPropertiesReindex(context.Background(), "Book", "title",
+		&models.ReindexPropertyIndexRequest{IndexSearchable: ptrBool(true)})
+	require.Error(t, err)
+}
+
+func TestSchemaObjectsPropertiesReindexRejectsEmptyRequest(t *testing.T) {
+	reader := &fakeSchemaReader{classes: map[string]bool{"Book": true}, properties: map[string]bool{"Book.title": true}}
+	r := NewPropertyReindexer(reader, &fakeRebuilder{})
+
+	_, err := r.SchemaObjectsPropertiesReindex(context.Background(), "Book", "title",
+		&models.ReindexPropertyIndexRequest{})
+	require.Error(t, err)
+}
+
+func TestSchemaObjectsPropertiesReindexIsIdempotentWhileRunning(t *testing.T) {
+	reader := &fakeSchemaReader{classes: map[string]bool{"Book": true}, properties: map[string]bool{"Book.title": true}}
+	rebuilder := &fakeRebuilder{done: make(chan struct{})}
+	r := NewPropertyReindexer(reader, rebuilder)
+	req := &models.ReindexPropertyIndexRequest{IndexSearchable: ptrBool(true)}
+
+	first, err := r.SchemaObjectsPropertiesReindex(context.Background(), "Book", "title", req)
+	require.NoError(t, err)
+
+	second, err := r.SchemaObjectsPropertiesReindex(context.Background(), "Book", "title", req)
+	require.NoError(t, err)
+	assert.Equal(t, first.JobID, second.JobID)
+
+	close(rebuilder.done)
+	require.Eventually(t, func() bool {
+		status := r.Status("Book", "title", true, false, false)
+		return status != nil && status.Status == ReindexStatusCompleted
+	}, time.Second, 5*time.Millisecond)
+
+	rebuilder.mu.Lock()
+	calls := rebuilder.calls
+	rebuilder.mu.Unlock()
+	assert.Equal(t, 1, calls, "a second call while the job is running must not start a redundant rebuild")
+}
+
+func TestSchemaObjectsPropertiesReindexReRunsAfterCompletion(t *testing.T) {
+	reader := &fakeSchemaReader{classes: map[string]bool{"Book": true}, properties: map[string]bool{"Book.title": true}}
+	rebuilder := &fakeRebuilder{}
+	r := NewPropertyReindexer(reader, rebuilder)
+	req := &models.ReindexPropertyIndexRequest{IndexSearchable: ptrBool(true)}
+
+	_, err := r.SchemaObjectsPropertiesReindex(context.Background(), "Book", "title", req)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		status := r.Status("Book", "title", true, false, false)
+		return status != nil && status.Status == ReindexStatusCompleted
+	}, time.Second, 5*time.Millisecond)
+
+	_, err = r.SchemaObjectsPropertiesReindex(context.Background(), "Book", "title", req)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		rebuilder.mu.Lock()
+		defer rebuilder.mu.Unlock()
+		return rebuilder.calls == 2
+	}, time.Second, 5*time.Millisecond, "a request for a key whose previous job already completed must start a fresh rebuild")
+}